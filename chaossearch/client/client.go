@@ -0,0 +1,203 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultMaxRetries     = 4
+	defaultRetryBaseDelay = 30 * time.Millisecond
+	defaultRetryMaxDelay  = 20 * time.Second
+)
+
+// Configuration holds everything needed to talk to a ChaosSearch deployment.
+type Configuration struct {
+	URL             string
+	AccessKeyID     string
+	SecretAccessKey string
+	Region          string
+
+	// MaxRetries is the number of additional attempts made after a retryable
+	// error, on top of the initial request.
+	MaxRetries int
+	// RetryBaseDelay and RetryMaxDelay bound the exponential backoff with full
+	// jitter used between retries.
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+
+	// LogLevel is the provider's configured log_level ("trace", "debug", ...).
+	// It gates how verbose the embedded AWS SDK S3 sessions are, on top of
+	// whatever TF_LOG_PROVIDER_CHAOSSEARCH already controls for tflog itself.
+	LogLevel string
+}
+
+// NewConfiguration returns a Configuration populated with the provider's retry defaults.
+func NewConfiguration() *Configuration {
+	return &Configuration{
+		MaxRetries:     defaultMaxRetries,
+		RetryBaseDelay: defaultRetryBaseDelay,
+		RetryMaxDelay:  defaultRetryMaxDelay,
+	}
+}
+
+type Client struct {
+	config     *Configuration
+	httpClient *http.Client
+}
+
+func NewClient(config *Configuration) *Client {
+	return &Client{
+		config:     config,
+		httpClient: &http.Client{},
+	}
+}
+
+// signAndDo signs req with the configured credentials and executes it, retrying
+// transient failures with exponential backoff and full jitter. bodyAsBytes is the
+// cached request body (nil for bodyless requests) and is used to rewind the body
+// before each retry, since req.Body can only be read once.
+func (client *Client) signAndDo(req *http.Request, bodyAsBytes []byte) (*http.Response, error) {
+	creds := credentials.NewStaticCredentials(client.config.AccessKeyID, client.config.SecretAccessKey, "")
+	signer := v4.NewSigner(creds)
+
+	var lastErr error
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			if bodyAsBytes != nil {
+				req.Body = io.NopCloser(bytes.NewReader(bodyAsBytes))
+			}
+		}
+
+		if _, err := signer.Sign(req, bodyReader(bodyAsBytes), "s3", client.config.Region, time.Now()); err != nil {
+			return nil, fmt.Errorf("Failed to sign request: %s", err)
+		}
+
+		start := time.Now()
+		resp, err := client.httpClient.Do(req)
+		duration := time.Since(start)
+
+		logFields := map[string]interface{}{
+			"operation":   req.Method,
+			"url":         req.URL.String(),
+			"duration_ms": duration.Milliseconds(),
+			"attempt":     attempt,
+		}
+		if resp != nil {
+			logFields["status"] = resp.StatusCode
+		}
+		if err != nil {
+			logFields["error"] = err.Error()
+		}
+		tflog.Debug(req.Context(), "chaossearch API request", logFields)
+
+		retryable, retryAfter := classifyForRetry(resp, err)
+		if !retryable || attempt >= client.config.MaxRetries {
+			if err != nil {
+				return nil, err
+			}
+			return resp, nil
+		}
+
+		if resp != nil {
+			resp.Body.Close()
+		}
+		lastErr = err
+
+		delay := retryAfter
+		if delay == 0 {
+			delay = backoffWithFullJitter(attempt, client.config.RetryBaseDelay, client.config.RetryMaxDelay)
+		}
+
+		select {
+		case <-req.Context().Done():
+			if lastErr != nil {
+				return nil, lastErr
+			}
+			return nil, req.Context().Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// classifyForRetry decides whether a response/error pair is worth retrying, and
+// returns the server-requested delay (from Retry-After) if one was given.
+func classifyForRetry(resp *http.Response, err error) (retryable bool, retryAfter time.Duration) {
+	if err != nil {
+		return isRetryableTransportError(err), 0
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return true, parseRetryAfter(resp)
+	case resp.StatusCode >= 500:
+		return true, parseRetryAfter(resp)
+	default:
+		return false, 0
+	}
+}
+
+// isRetryableTransportError reports whether err looks like a transient
+// network failure (timed out, connection refused/reset) that's worth a
+// retry, as opposed to a permanent failure (bad scheme, TLS/cert error,
+// unresolved host) that will just fail the same way on every attempt.
+func isRetryableTransportError(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET)
+}
+
+func parseRetryAfter(resp *http.Response) time.Duration {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if when, err := http.ParseTime(value); err == nil {
+		return time.Until(when)
+	}
+
+	return 0
+}
+
+// backoffWithFullJitter implements the AWS SDK's "full jitter" strategy:
+// sleep = random(0, min(maxDelay, base*2^attempt)).
+func backoffWithFullJitter(attempt int, base, maxDelay time.Duration) time.Duration {
+	ceiling := base << attempt
+	if ceiling <= 0 || ceiling > maxDelay {
+		ceiling = maxDelay
+	}
+
+	return time.Duration(rand.Int63n(int64(ceiling) + 1))
+}
+
+func bodyReader(bodyAsBytes []byte) io.ReadSeeker {
+	if bodyAsBytes == nil {
+		return bytes.NewReader([]byte{})
+	}
+	return bytes.NewReader(bodyAsBytes)
+}
+
+func (client *Client) unmarshalJSONBody(body io.Reader, v interface{}) error {
+	return json.NewDecoder(body).Decode(v)
+}
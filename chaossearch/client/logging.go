@@ -0,0 +1,42 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// awsLogSubsystem is the tflog subsystem the embedded AWS SDK session logs
+// into, so `TF_LOG_PROVIDER_CHAOSSEARCH=debug` also surfaces S3 client chatter
+// instead of it being silently dropped.
+const awsLogSubsystem = "aws"
+
+// appLogger adapts the AWS SDK's aws.Logger interface to tflog, scoped to the
+// context of whichever provider operation created the AWS session.
+type appLogger struct {
+	ctx context.Context
+}
+
+func newAppLogger(ctx context.Context) appLogger {
+	return appLogger{ctx: tflog.NewSubsystem(ctx, awsLogSubsystem)}
+}
+
+func (l appLogger) Log(args ...interface{}) {
+	tflog.SubsystemTrace(l.ctx, awsLogSubsystem, fmt.Sprint(args...))
+}
+
+// awsSDKLogLevel maps the provider's configured log level to the AWS SDK's
+// own LogLevelType. At LogOff the SDK never calls the Logger at all, so
+// without this the "aws" subsystem above never fires regardless of how
+// verbose tflog itself is configured.
+func awsSDKLogLevel(level string) aws.LogLevelType {
+	switch strings.ToLower(level) {
+	case "debug", "trace":
+		return aws.LogDebugWithHTTPBody
+	default:
+		return aws.LogOff
+	}
+}
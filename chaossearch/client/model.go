@@ -10,7 +10,21 @@ type BucketCollection struct {
 }
 
 type ListBucketsResponse struct {
-	BucketsCollection BucketCollection `xml:"Buckets"`
+	BucketsCollection     BucketCollection `xml:"Buckets"`
+	IsTruncated           bool             `xml:"IsTruncated"`
+	NextContinuationToken string           `xml:"NextContinuationToken"`
+}
+
+type ListObjectGroupsRequest struct {
+	Prefix            string
+	MaxResults        int
+	ContinuationToken string
+}
+
+type ListObjectGroupsResponse struct {
+	Names                 []string
+	IsTruncated           bool
+	NextContinuationToken string
 }
 
 type ReadObjectGroupRequest struct {
@@ -27,8 +41,11 @@ type ReadObjectGroupResponse struct {
 	SourceBucket      string
 	IndexRetention    int
 	ArrayFlattenDepth *int
+	KeepOriginal      bool
+	Horizontal        bool
 	ColumnRenames     map[string]string
 	ColumnSelection   []map[string]interface{}
+	ColumnTypes       map[string]string
 }
 
 type CreateObjectGroupRequest struct {
@@ -42,8 +59,10 @@ type CreateObjectGroupRequest struct {
 	Pattern           string
 	IndexRetention    int
 	ArrayFlattenDepth *int
+	KeepOriginal      bool
+	Horizontal        bool
 	ColumnRenames     map[string]interface{}
-	ColumnSelection   map[string]interface{}
+	ColumnSelection   []map[string]interface{}
 }
 
 type UpdateIndexingStateRequest struct {
@@ -73,3 +92,69 @@ type IndexingState struct {
 	ObjectGroupName string
 	Active          bool
 }
+
+type ReadViewRequest struct {
+	ID string
+}
+
+type ReadViewResponse struct {
+	Name            string
+	ObjectGroups    []string
+	TimeField       string
+	PartitionBy     string
+	FilterJSON      string
+	IndexPattern    string
+	CaseInsensitive bool
+}
+
+type CreateViewRequest struct {
+	Name            string
+	ObjectGroups    []string
+	TimeField       string
+	PartitionBy     string
+	FilterJSON      string
+	IndexPattern    string
+	CaseInsensitive bool
+}
+
+type UpdateViewRequest struct {
+	Name            string
+	ObjectGroups    []string
+	TimeField       string
+	PartitionBy     string
+	FilterJSON      string
+	IndexPattern    string
+	CaseInsensitive bool
+}
+
+type DeleteViewRequest struct {
+	Name string
+}
+
+type ListViewsRequest struct{}
+
+type ListViewsResponse struct {
+	Views []string
+}
+
+type CreateAccessKeyRequest struct {
+	UserName string
+}
+
+type CreateAccessKeyResponse struct {
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+type DeleteAccessKeyRequest struct {
+	UserName    string
+	AccessKeyID string
+}
+
+type ListAccessKeysRequest struct {
+	UserName string
+}
+
+type ListAccessKeysResponse struct {
+	AccessKeyIDs []string
+}
@@ -0,0 +1,46 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+func (client *Client) CreateAccessKey(ctx context.Context, req *CreateAccessKeyRequest) (*CreateAccessKeyResponse, error) {
+	method := "POST"
+	url := fmt.Sprintf("%s/User/accessKey", client.config.URL)
+
+	body := map[string]interface{}{
+		"userName": req.UserName,
+	}
+	bodyAsBytes, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(bodyAsBytes))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create request: %s", err)
+	}
+
+	httpResp, err := client.signAndDo(httpReq, bodyAsBytes)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to %s to %s: %s", method, url, err)
+	}
+	defer httpResp.Body.Close()
+
+	var createResp struct {
+		AccessKeyID     string `json:"accessKeyId"`
+		SecretAccessKey string `json:"secretAccessKey"`
+	}
+	if err := client.unmarshalJSONBody(httpResp.Body, &createResp); err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal JSON response body: %s", err)
+	}
+
+	return &CreateAccessKeyResponse{
+		AccessKeyID:     createResp.AccessKeyID,
+		SecretAccessKey: createResp.SecretAccessKey,
+	}, nil
+}
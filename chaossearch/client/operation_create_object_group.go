@@ -0,0 +1,116 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+func (client *Client) CreateObjectGroup(ctx context.Context, req *CreateObjectGroupRequest) error {
+	if err := client.createBucketWithTagging(ctx, req); err != nil {
+		return err
+	}
+
+	return client.configureDatasetOptions(ctx, req)
+}
+
+func (client *Client) createBucketWithTagging(ctx context.Context, req *CreateObjectGroupRequest) error {
+	awsSession, err := session.NewSession(&aws.Config{
+		Credentials:      credentials.NewStaticCredentials(client.config.AccessKeyID, client.config.SecretAccessKey, ""),
+		Endpoint:         aws.String(fmt.Sprintf("%s/V1", client.config.URL)),
+		Region:           aws.String(client.config.Region),
+		S3ForcePathStyle: aws.Bool(true),
+		LogLevel:         aws.LogLevel(awsSDKLogLevel(client.config.LogLevel)),
+		Logger:           newAppLogger(ctx),
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to create AWS session: %s", err)
+	}
+
+	svc := s3.New(awsSession)
+
+	if _, err := svc.CreateBucketWithContext(ctx, &s3.CreateBucketInput{Bucket: aws.String(req.Name)}); err != nil {
+		return fmt.Errorf("Failed to create object group bucket: %s", err)
+	}
+
+	tags, err := objectGroupTags(req)
+	if err != nil {
+		return err
+	}
+
+	_, err = svc.PutBucketTaggingWithContext(ctx, &s3.PutBucketTaggingInput{
+		Bucket:  aws.String(req.Name),
+		Tagging: &s3.Tagging{TagSet: tags},
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to tag object group bucket: %s", err)
+	}
+
+	return nil
+}
+
+func objectGroupTags(req *CreateObjectGroupRequest) ([]*s3.Tag, error) {
+	format := map[string]interface{}{
+		"_type":             req.Format,
+		"pattern":           req.Pattern,
+		"arrayFlattenDepth": req.ArrayFlattenDepth,
+		"keepOriginal":      req.KeepOriginal,
+		"horizontal":        req.Horizontal,
+	}
+	formatJSON, err := json.Marshal(format)
+	if err != nil {
+		return nil, err
+	}
+
+	retentionJSON, err := json.Marshal(map[string]interface{}{"overall": req.IndexRetention})
+	if err != nil {
+		return nil, err
+	}
+
+	return []*s3.Tag{
+		{Key: aws.String("cs3.parent"), Value: aws.String(req.SourceBucket)},
+		{Key: aws.String("cs3.compression"), Value: aws.String(req.Compression)},
+		{Key: aws.String("cs3.live-sqs-arn"), Value: aws.String(req.LiveEventsSqsArn)},
+		{Key: aws.String("cs3.dataset-format"), Value: aws.String(string(formatJSON))},
+		{Key: aws.String("cs3.predicate"), Value: aws.String(req.FilterJSON)},
+		{Key: aws.String("cs3.index-retention"), Value: aws.String(string(retentionJSON))},
+	}, nil
+}
+
+func (client *Client) configureDatasetOptions(ctx context.Context, req *CreateObjectGroupRequest) error {
+	method := "POST"
+	url := fmt.Sprintf("%s/Bucket/dataset", client.config.URL)
+
+	body := map[string]interface{}{
+		"name":        req.Name,
+		"partitionBy": req.PartitionBy,
+		"options": map[string]interface{}{
+			"colRenames":   req.ColumnRenames,
+			"colSelection": req.ColumnSelection,
+		},
+	}
+	bodyAsBytes, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(bodyAsBytes))
+	if err != nil {
+		return fmt.Errorf("Failed to create request: %s", err)
+	}
+
+	httpResp, err := client.signAndDo(httpReq, bodyAsBytes)
+	if err != nil {
+		return fmt.Errorf("Failed to %s to %s: %s", method, url, err)
+	}
+	defer httpResp.Body.Close()
+
+	return nil
+}
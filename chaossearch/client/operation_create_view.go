@@ -0,0 +1,49 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+func (client *Client) CreateView(ctx context.Context, req *CreateViewRequest) error {
+	method := "POST"
+	url := fmt.Sprintf("%s/Bucket/dataset", client.config.URL)
+
+	bodyAsBytes, err := marshalViewRequest(req.Name, req.ObjectGroups, req.TimeField, req.PartitionBy, req.FilterJSON, req.IndexPattern, req.CaseInsensitive)
+	if err != nil {
+		return err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(bodyAsBytes))
+	if err != nil {
+		return fmt.Errorf("Failed to create request: %s", err)
+	}
+
+	httpResp, err := client.signAndDo(httpReq, bodyAsBytes)
+	if err != nil {
+		return fmt.Errorf("Failed to %s to %s: %s", method, url, err)
+	}
+	defer httpResp.Body.Close()
+
+	return nil
+}
+
+func marshalViewRequest(name string, objectGroups []string, timeField string, partitionBy string, filterJSON string, indexPattern string, caseInsensitive bool) ([]byte, error) {
+	body := map[string]interface{}{
+		"_type":       "view",
+		"name":        name,
+		"partitionBy": partitionBy,
+		"options": map[string]interface{}{
+			"objectGroups":    objectGroups,
+			"timeField":       timeField,
+			"predicate":       filterJSON,
+			"indexPattern":    indexPattern,
+			"caseInsensitive": caseInsensitive,
+		},
+	}
+
+	return json.Marshal(body)
+}
@@ -0,0 +1,26 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+func (client *Client) DeleteAccessKey(ctx context.Context, req *DeleteAccessKeyRequest) error {
+	method := "DELETE"
+	deleteURL := fmt.Sprintf("%s/User/accessKey/%s?userName=%s", client.config.URL, req.AccessKeyID, url.QueryEscape(req.UserName))
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, deleteURL, nil)
+	if err != nil {
+		return fmt.Errorf("Failed to create request: %s", err)
+	}
+
+	httpResp, err := client.signAndDo(httpReq, nil)
+	if err != nil {
+		return fmt.Errorf("Failed to %s to %s: %s", method, deleteURL, err)
+	}
+	defer httpResp.Body.Close()
+
+	return nil
+}
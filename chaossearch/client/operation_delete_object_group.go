@@ -0,0 +1,33 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+func (client *Client) DeleteObjectGroup(ctx context.Context, req *DeleteObjectGroupRequest) error {
+	awsSession, err := session.NewSession(&aws.Config{
+		Credentials:      credentials.NewStaticCredentials(client.config.AccessKeyID, client.config.SecretAccessKey, ""),
+		Endpoint:         aws.String(fmt.Sprintf("%s/V1", client.config.URL)),
+		Region:           aws.String(client.config.Region),
+		S3ForcePathStyle: aws.Bool(true),
+		LogLevel:         aws.LogLevel(awsSDKLogLevel(client.config.LogLevel)),
+		Logger:           newAppLogger(ctx),
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to create AWS session: %s", err)
+	}
+
+	svc := s3.New(awsSession)
+
+	if _, err := svc.DeleteBucketWithContext(ctx, &s3.DeleteBucketInput{Bucket: aws.String(req.Name)}); err != nil {
+		return fmt.Errorf("Failed to delete object group bucket: %s", err)
+	}
+
+	return nil
+}
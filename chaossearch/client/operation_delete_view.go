@@ -0,0 +1,25 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+func (client *Client) DeleteView(ctx context.Context, req *DeleteViewRequest) error {
+	method := "DELETE"
+	url := fmt.Sprintf("%s/Bucket/dataset/name/%s", client.config.URL, req.Name)
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return fmt.Errorf("Failed to create request: %s", err)
+	}
+
+	httpResp, err := client.signAndDo(httpReq, nil)
+	if err != nil {
+		return fmt.Errorf("Failed to %s to %s: %s", method, url, err)
+	}
+	defer httpResp.Body.Close()
+
+	return nil
+}
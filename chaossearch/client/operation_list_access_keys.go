@@ -0,0 +1,40 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+func (client *Client) ListAccessKeys(ctx context.Context, req *ListAccessKeysRequest) (*ListAccessKeysResponse, error) {
+	method := "GET"
+	listURL := fmt.Sprintf("%s/User/accessKey?userName=%s", client.config.URL, url.QueryEscape(req.UserName))
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, listURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create request: %s", err)
+	}
+
+	httpResp, err := client.signAndDo(httpReq, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to %s to %s: %s", method, listURL, err)
+	}
+	defer httpResp.Body.Close()
+
+	var listResp struct {
+		AccessKeys []struct {
+			AccessKeyID string `json:"accessKeyId"`
+		} `json:"accessKeys"`
+	}
+	if err := client.unmarshalJSONBody(httpResp.Body, &listResp); err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal JSON response body: %s", err)
+	}
+
+	resp := &ListAccessKeysResponse{}
+	for _, accessKey := range listResp.AccessKeys {
+		resp.AccessKeyIDs = append(resp.AccessKeyIDs, accessKey.AccessKeyID)
+	}
+
+	return resp, nil
+}
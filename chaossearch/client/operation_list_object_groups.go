@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// ListObjectGroups returns a single page of object group names. Callers that
+// want the full set should keep calling it with the returned
+// NextContinuationToken until IsTruncated is false.
+func (client *Client) ListObjectGroups(ctx context.Context, req *ListObjectGroupsRequest) (*ListObjectGroupsResponse, error) {
+	method := "GET"
+	listURL := fmt.Sprintf("%s/V1", client.config.URL)
+
+	query := url.Values{}
+	if req.Prefix != "" {
+		query.Set("prefix", req.Prefix)
+	}
+	if req.MaxResults > 0 {
+		query.Set("max-keys", strconv.Itoa(req.MaxResults))
+	}
+	if req.ContinuationToken != "" {
+		query.Set("continuation-token", req.ContinuationToken)
+	}
+	if encoded := query.Encode(); encoded != "" {
+		listURL = fmt.Sprintf("%s?%s", listURL, encoded)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, listURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create request: %s", err)
+	}
+
+	httpResp, err := client.signAndDo(httpReq, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to %s to %s: %s", method, listURL, err)
+	}
+	defer httpResp.Body.Close()
+
+	var listResp ListBucketsResponse
+	if err := xml.NewDecoder(httpResp.Body).Decode(&listResp); err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal XML response body: %s", err)
+	}
+
+	resp := &ListObjectGroupsResponse{
+		IsTruncated:           listResp.IsTruncated,
+		NextContinuationToken: listResp.NextContinuationToken,
+	}
+	for _, bucket := range listResp.BucketsCollection.Buckets {
+		resp.Names = append(resp.Names, bucket.Name)
+	}
+
+	return resp, nil
+}
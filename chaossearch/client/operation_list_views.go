@@ -0,0 +1,39 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+func (client *Client) ListViews(ctx context.Context, req *ListViewsRequest) (*ListViewsResponse, error) {
+	method := "GET"
+	url := fmt.Sprintf("%s/Bucket/dataset?type=view", client.config.URL)
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create request: %s", err)
+	}
+
+	httpResp, err := client.signAndDo(httpReq, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to %s to %s: %s", method, url, err)
+	}
+	defer httpResp.Body.Close()
+
+	var listDatasetsResp struct {
+		Datasets []struct {
+			Name string `json:"name"`
+		} `json:"datasets"`
+	}
+	if err := client.unmarshalJSONBody(httpResp.Body, &listDatasetsResp); err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal JSON response body: %s", err)
+	}
+
+	resp := &ListViewsResponse{}
+	for _, dataset := range listDatasetsResp.Datasets {
+		resp.Views = append(resp.Views, dataset.Name)
+	}
+
+	return resp, nil
+}
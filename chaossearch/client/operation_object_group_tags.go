@@ -0,0 +1,147 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// managedObjectGroupTagPrefix is the tag namespace ChaosSearch itself writes
+// to (cs3.parent, cs3.compression, cs3.dataset-format, and any future
+// cs3.* key). The whole namespace must never be clobbered or reported as
+// drift by chaossearch_object_group_tags, so it's treated as managed by
+// prefix rather than by an incomplete list of known keys.
+const managedObjectGroupTagPrefix = "cs3."
+
+func isManagedObjectGroupTagKey(key string) bool {
+	return strings.HasPrefix(key, managedObjectGroupTagPrefix)
+}
+
+type ReadObjectGroupTagsRequest struct {
+	ObjectGroupName string
+}
+
+type ReadObjectGroupTagsResponse struct {
+	Tags map[string]string
+}
+
+type UpdateObjectGroupTagsRequest struct {
+	ObjectGroupName string
+	Tags            map[string]string
+}
+
+func (client *Client) newS3Client(ctx context.Context) (*s3.S3, error) {
+	awsSession, err := session.NewSession(&aws.Config{
+		Credentials:      credentials.NewStaticCredentials(client.config.AccessKeyID, client.config.SecretAccessKey, ""),
+		Endpoint:         aws.String(fmt.Sprintf("%s/V1", client.config.URL)),
+		Region:           aws.String(client.config.Region),
+		S3ForcePathStyle: aws.Bool(true),
+		LogLevel:         aws.LogLevel(awsSDKLogLevel(client.config.LogLevel)),
+		Logger:           newAppLogger(ctx),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create AWS session: %s", err)
+	}
+
+	return s3.New(awsSession), nil
+}
+
+// ReadObjectGroupTags returns only the user-defined tags on the object group's
+// bucket, filtering out the cs3.* keys that ChaosSearch itself manages.
+func (client *Client) ReadObjectGroupTags(ctx context.Context, req *ReadObjectGroupTagsRequest) (*ReadObjectGroupTagsResponse, error) {
+	svc, err := client.newS3Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tagging, err := svc.GetBucketTaggingWithContext(ctx, &s3.GetBucketTaggingInput{
+		Bucket: aws.String(req.ObjectGroupName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read bucket tagging: %s", err)
+	}
+
+	tags := map[string]string{}
+	for _, tag := range tagging.TagSet {
+		if isManagedObjectGroupTagKey(*tag.Key) {
+			continue
+		}
+		tags[*tag.Key] = *tag.Value
+	}
+
+	return &ReadObjectGroupTagsResponse{Tags: tags}, nil
+}
+
+// UpdateObjectGroupTags merges the given user tags into the bucket's tag set,
+// preserving whatever cs3.* tags ChaosSearch has already written.
+func (client *Client) UpdateObjectGroupTags(ctx context.Context, req *UpdateObjectGroupTagsRequest) error {
+	svc, err := client.newS3Client(ctx)
+	if err != nil {
+		return err
+	}
+
+	existing, err := svc.GetBucketTaggingWithContext(ctx, &s3.GetBucketTaggingInput{
+		Bucket: aws.String(req.ObjectGroupName),
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to read bucket tagging: %s", err)
+	}
+
+	merged := []*s3.Tag{}
+	for _, tag := range existing.TagSet {
+		if isManagedObjectGroupTagKey(*tag.Key) {
+			merged = append(merged, tag)
+		}
+	}
+	for key, value := range req.Tags {
+		merged = append(merged, &s3.Tag{Key: aws.String(key), Value: aws.String(value)})
+	}
+
+	_, err = svc.PutBucketTaggingWithContext(ctx, &s3.PutBucketTaggingInput{
+		Bucket:  aws.String(req.ObjectGroupName),
+		Tagging: &s3.Tagging{TagSet: merged},
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to update bucket tagging: %s", err)
+	}
+
+	return nil
+}
+
+// DeleteObjectGroupTags removes the given user tag keys, preserving the cs3.*
+// tags ChaosSearch manages.
+func (client *Client) DeleteObjectGroupTags(ctx context.Context, objectGroupName string) error {
+	svc, err := client.newS3Client(ctx)
+	if err != nil {
+		return err
+	}
+
+	existing, err := svc.GetBucketTaggingWithContext(ctx, &s3.GetBucketTaggingInput{
+		Bucket: aws.String(objectGroupName),
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to read bucket tagging: %s", err)
+	}
+
+	kept := []*s3.Tag{}
+	for _, tag := range existing.TagSet {
+		if isManagedObjectGroupTagKey(*tag.Key) {
+			kept = append(kept, tag)
+		}
+	}
+
+	_, err = svc.PutBucketTaggingWithContext(ctx, &s3.PutBucketTaggingInput{
+		Bucket:  aws.String(objectGroupName),
+		Tagging: &s3.Tagging{TagSet: kept},
+	})
+	if err != nil {
+		return fmt.Errorf("Failed to update bucket tagging: %s", err)
+	}
+
+	return nil
+}
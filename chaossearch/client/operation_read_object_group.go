@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 	"net/http"
 	"strings"
 
@@ -12,14 +11,9 @@ import (
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-type appLogger struct{}
-
-func (l appLogger) Log(args ...interface{}) {
-	log.Printf("AWS: %+v", args...)
-}
-
 type InputFilter struct {
     AND []struct {
         Field string `json:"field"`
@@ -74,7 +68,7 @@ func (client *Client) ReadObjectGroup(ctx context.Context, req *ReadObjectGroupR
 		return nil, err
 	}
 
-	log.Printf("ReadObjectGroupResponse: %+v", resp)
+	tflog.Debug(ctx, "read object group", map[string]interface{}{"object_group": req.ID, "response": fmt.Sprintf("%+v", resp)})
 
 	return &resp, nil
 }
@@ -128,8 +122,8 @@ func (client *Client) readAttributesFromBucketTagging(ctx context.Context, req *
 		Endpoint:         aws.String(fmt.Sprintf("%s/V1", client.config.URL)),
 		Region:           aws.String(client.config.Region),
 		S3ForcePathStyle: aws.Bool(true),
-		LogLevel:         aws.LogLevel(aws.LogOff),
-		Logger:           appLogger{},
+		LogLevel:         aws.LogLevel(awsSDKLogLevel(client.config.LogLevel)),
+		Logger:           newAppLogger(ctx),
 	})
 	if err != nil {
 		return fmt.Errorf("Failed to create AWS session: %s", err)
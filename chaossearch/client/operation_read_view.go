@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func (client *Client) ReadView(ctx context.Context, req *ReadViewRequest) (*ReadViewResponse, error) {
+	method := "GET"
+	url := fmt.Sprintf("%s/Bucket/dataset/name/%s", client.config.URL, req.ID)
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create request: %s", err)
+	}
+
+	httpResp, err := client.signAndDo(httpReq, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to %s to %s: %s", method, url, err)
+	}
+	defer httpResp.Body.Close()
+
+	var getDatasetResp struct {
+		Name        string `json:"name"`
+		PartitionBy string `json:"partitionBy"`
+		Options     struct {
+			ObjectGroups    []string `json:"objectGroups"`
+			TimeField       string   `json:"timeField"`
+			Predicate       string   `json:"predicate"`
+			IndexPattern    string   `json:"indexPattern"`
+			CaseInsensitive bool     `json:"caseInsensitive"`
+		} `json:"options"`
+	}
+	if err := client.unmarshalJSONBody(httpResp.Body, &getDatasetResp); err != nil {
+		return nil, fmt.Errorf("Failed to unmarshal JSON response body: %s", err)
+	}
+
+	filterJSON := getDatasetResp.Options.Predicate
+	if strings.Contains(filterJSON, "pattern") {
+		// Same quickfix as object groups: the platform echoes the predicate back in its
+		// internal "pattern" shape rather than the shape it was written in.
+		desiredFilterJSON, err := ConvertFilterJSON(filterJSON)
+		if err != nil {
+			return nil, err
+		}
+		filterJSON = desiredFilterJSON
+	}
+
+	resp := &ReadViewResponse{
+		Name:            getDatasetResp.Name,
+		ObjectGroups:    getDatasetResp.Options.ObjectGroups,
+		TimeField:       getDatasetResp.Options.TimeField,
+		PartitionBy:     getDatasetResp.PartitionBy,
+		FilterJSON:      filterJSON,
+		IndexPattern:    getDatasetResp.Options.IndexPattern,
+		CaseInsensitive: getDatasetResp.Options.CaseInsensitive,
+	}
+
+	tflog.Debug(ctx, "read view", map[string]interface{}{"view": req.ID, "response": fmt.Sprintf("%+v", resp)})
+
+	return resp, nil
+}
@@ -0,0 +1,37 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+func (client *Client) UpdateObjectGroup(ctx context.Context, req *UpdateObjectGroupRequest) error {
+	method := "POST"
+	url := fmt.Sprintf("%s/Bucket/dataset/name/%s", client.config.URL, req.Name)
+
+	body := map[string]interface{}{
+		"retention": map[string]interface{}{
+			"overall": req.IndexRetention,
+		},
+	}
+	bodyAsBytes, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(bodyAsBytes))
+	if err != nil {
+		return fmt.Errorf("Failed to create request: %s", err)
+	}
+
+	httpResp, err := client.signAndDo(httpReq, bodyAsBytes)
+	if err != nil {
+		return fmt.Errorf("Failed to %s to %s: %s", method, url, err)
+	}
+	defer httpResp.Body.Close()
+
+	return nil
+}
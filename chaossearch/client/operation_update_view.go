@@ -0,0 +1,31 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+)
+
+func (client *Client) UpdateView(ctx context.Context, req *UpdateViewRequest) error {
+	method := "PUT"
+	url := fmt.Sprintf("%s/Bucket/dataset/name/%s", client.config.URL, req.Name)
+
+	bodyAsBytes, err := marshalViewRequest(req.Name, req.ObjectGroups, req.TimeField, req.PartitionBy, req.FilterJSON, req.IndexPattern, req.CaseInsensitive)
+	if err != nil {
+		return err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(bodyAsBytes))
+	if err != nil {
+		return fmt.Errorf("Failed to create request: %s", err)
+	}
+
+	httpResp, err := client.signAndDo(httpReq, bodyAsBytes)
+	if err != nil {
+		return fmt.Errorf("Failed to %s to %s: %s", method, url, err)
+	}
+	defer httpResp.Body.Close()
+
+	return nil
+}
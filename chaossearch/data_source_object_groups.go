@@ -0,0 +1,202 @@
+package chaossearch
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-chaossearch/chaossearch/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceObjectGroups -
+func dataSourceObjectGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceObjectGroupsRead,
+		Schema: map[string]*schema.Schema{
+			"prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"max_results": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"continuation_token": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"include_details": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"next_continuation_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"object_groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_bucket": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"compression": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"format": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"index_retention": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceObjectGroupsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	csClient := m.(*ProviderMeta).Client
+
+	names, nextToken, err := listAllObjectGroupNames(ctx, csClient, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	names, err = applyObjectGroupFilters(names, d.Get("filter").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	objectGroups := make([]map[string]interface{}, 0, len(names))
+	includeDetails := d.Get("include_details").(bool)
+	for _, name := range names {
+		objectGroup := map[string]interface{}{"name": name}
+
+		if includeDetails {
+			details, err := csClient.ReadObjectGroup(ctx, &client.ReadObjectGroupRequest{ID: name})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			objectGroup["source_bucket"] = details.SourceBucket
+			objectGroup["compression"] = details.Compression
+			objectGroup["format"] = details.Format
+			objectGroup["index_retention"] = details.IndexRetention
+		}
+
+		objectGroups = append(objectGroups, objectGroup)
+	}
+
+	if err := d.Set("object_groups", objectGroups); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("next_continuation_token", nextToken); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("chaossearch_object_groups")
+
+	return nil
+}
+
+// listAllObjectGroupNames pages through ListObjectGroups. When the caller set
+// max_results it stops after that many results and returns the continuation
+// token so they can page manually; otherwise it transparently follows
+// NextContinuationToken until the listing is exhausted.
+func listAllObjectGroupNames(ctx context.Context, csClient *client.Client, d *schema.ResourceData) ([]string, string, error) {
+	prefix := d.Get("prefix").(string)
+	maxResults := d.Get("max_results").(int)
+	continuationToken := d.Get("continuation_token").(string)
+
+	var names []string
+	for {
+		resp, err := csClient.ListObjectGroups(ctx, &client.ListObjectGroupsRequest{
+			Prefix:            prefix,
+			MaxResults:        maxResults,
+			ContinuationToken: continuationToken,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		names = append(names, resp.Names...)
+
+		if !resp.IsTruncated || maxResults > 0 {
+			return names, resp.NextContinuationToken, nil
+		}
+
+		if resp.NextContinuationToken == "" {
+			return nil, "", fmt.Errorf("ListObjectGroups reported IsTruncated but returned no NextContinuationToken")
+		}
+
+		continuationToken = resp.NextContinuationToken
+	}
+}
+
+// applyObjectGroupFilters supports a single filter name, "name", matched
+// against the "values" set. Any other filter name is rejected rather than
+// silently ignored, since a typo'd filter that matches nothing would
+// otherwise return the full, unfiltered list.
+func applyObjectGroupFilters(names []string, filters []interface{}) ([]string, error) {
+	if len(filters) == 0 {
+		return names, nil
+	}
+
+	for _, rawFilter := range filters {
+		filter := rawFilter.(map[string]interface{})
+		filterName := filter["name"].(string)
+		if filterName != "name" {
+			return nil, fmt.Errorf("unsupported filter name %q: only \"name\" is supported", filterName)
+		}
+
+		values := filter["values"].(*schema.Set).List()
+		names = filterByValues(names, values)
+	}
+
+	return names, nil
+}
+
+func filterByValues(names []string, values []interface{}) []string {
+	wanted := make(map[string]bool, len(values))
+	for _, v := range values {
+		wanted[v.(string)] = true
+	}
+
+	filtered := names[:0]
+	for _, name := range names {
+		if wanted[name] {
+			filtered = append(filtered, name)
+		}
+	}
+
+	return filtered
+}
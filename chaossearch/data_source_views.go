@@ -0,0 +1,40 @@
+package chaossearch
+
+import (
+	"context"
+	"terraform-provider-chaossearch/chaossearch/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceViews -
+func dataSourceViews() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceViewsRead,
+		Schema: map[string]*schema.Schema{
+			"views": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceViewsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	csClient := m.(*ProviderMeta).Client
+
+	resp, err := csClient.ListViews(ctx, &client.ListViewsRequest{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("views", resp.Views); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("chaossearch_views")
+
+	return nil
+}
@@ -2,10 +2,10 @@ package chaossearch
 
 import (
 	"context"
-	"log"
-	"os"
 	"terraform-provider-chaossearch/chaossearch/client"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -38,12 +38,36 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("CHAOSSEARCH_REGION", "eu-west-1"),
 			},
+			"max_retries": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CHAOSSEARCH_MAX_RETRIES", 4),
+			},
+			"retry_base_delay_ms": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CHAOSSEARCH_RETRY_BASE_DELAY_MS", 30),
+			},
+			"retry_max_delay_ms": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("CHAOSSEARCH_RETRY_MAX_DELAY_MS", 20000),
+			},
+			"log_level": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TF_LOG_PROVIDER_CHAOSSEARCH", ""),
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"chaossearch_object_group": resourceObjectGroup(),
+			"chaossearch_access_key":        resourceAccessKey(),
+			"chaossearch_object_group":      resourceObjectGroup(),
+			"chaossearch_object_group_tags": resourceObjectGroupTags(),
+			"chaossearch_view":              resourceView(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"chaossearch_object_groups": dataSourceObjectGroups(),
+			"chaossearch_views":         dataSourceViews(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
@@ -54,6 +78,10 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 	accessKeyID := d.Get("access_key_id").(string)
 	secretAccessKey := d.Get("secret_access_key").(string)
 	region := d.Get("region").(string)
+	maxRetries := d.Get("max_retries").(int)
+	retryBaseDelayMs := d.Get("retry_base_delay_ms").(int)
+	retryMaxDelayMs := d.Get("retry_max_delay_ms").(int)
+	logLevel := d.Get("log_level").(string)
 
 	if url == "" {
 		return nil, diag.Errorf("Expected 'url' to be defined in provider configuration, but it was not")
@@ -73,28 +101,27 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 	config.AccessKeyID = accessKeyID
 	config.SecretAccessKey = secretAccessKey
 	config.Region = region
+	config.MaxRetries = maxRetries
+	config.RetryBaseDelay = time.Duration(retryBaseDelayMs) * time.Millisecond
+	config.RetryMaxDelay = time.Duration(retryMaxDelayMs) * time.Millisecond
+	config.LogLevel = logLevel
 
 	csClient := client.NewClient(config)
 
-	logFile, err := os.Create("terraform-provider-chaossearch.log")
-	if err != nil {
-		return nil, diag.FromErr(err)
-	}
-
-	log.SetOutput(logFile)
-	log.SetFlags(log.LstdFlags | log.Lshortfile)
-	// Close logFile when context is closed
-	go func() {
-		<-context.Background().Done()
-		log.Println("END")
-		logFile.Sync()
-		logFile.Close()
-	}()
-
 	providerMeta := &ProviderMeta{
 		Client: csClient,
 	}
 
-	log.Println("START")
+	// log_level (schema field, defaulting to TF_LOG_PROVIDER_CHAOSSEARCH) gates
+	// how verbose the embedded AWS SDK S3 sessions are. tflog's own verbosity
+	// is still controlled separately by Terraform's standard TF_LOG /
+	// TF_LOG_PROVIDER_CHAOSSEARCH mechanism.
+	tflog.Debug(ctx, "configured chaossearch provider", map[string]interface{}{
+		"url":         url,
+		"region":      region,
+		"max_retries": maxRetries,
+		"log_level":   logLevel,
+	})
+
 	return providerMeta, nil
 }
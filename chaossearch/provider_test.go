@@ -0,0 +1,40 @@
+package chaossearch
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var testAccProvider *schema.Provider
+var testAccProviderFactories = map[string]func() (*schema.Provider, error){
+	"chaossearch": func() (*schema.Provider, error) {
+		return testAccProvider, nil
+	},
+}
+
+func init() {
+	testAccProvider = Provider()
+}
+
+func TestProvider(t *testing.T) {
+	if err := Provider().InternalValidate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+// testAccPreCheck verifies the provider has everything it needs to run
+// acceptance tests against a real ChaosSearch instance.
+func testAccPreCheck(t *testing.T) {
+	for _, envVar := range []string{
+		"CHAOSSEARCH_URL",
+		"CHAOSSEARCH_ACCESS_KEY_ID",
+		"CHAOSSEARCH_SECRET_ACCESS_KEY",
+		"CHAOSSEARCH_TEST_SOURCE_BUCKET",
+	} {
+		if os.Getenv(envVar) == "" {
+			t.Fatalf("%s must be set for acceptance tests", envVar)
+		}
+	}
+}
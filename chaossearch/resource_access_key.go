@@ -0,0 +1,98 @@
+package chaossearch
+
+import (
+	"context"
+	"terraform-provider-chaossearch/chaossearch/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceAccessKey manages a ChaosSearch API credential. Like AWS IAM access
+// keys, the secret is only ever returned at creation time, so rotating one
+// means creating a new key (use `lifecycle { create_before_destroy = true }`)
+// rather than updating this resource in place.
+func resourceAccessKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAccessKeyCreate,
+		ReadContext:   resourceAccessKeyRead,
+		DeleteContext: resourceAccessKeyDelete,
+		Schema: map[string]*schema.Schema{
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"access_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"secret_access_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceAccessKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	csClient := m.(*ProviderMeta).Client
+
+	resp, err := csClient.CreateAccessKey(ctx, &client.CreateAccessKeyRequest{
+		UserName: d.Get("user_name").(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resp.AccessKeyID)
+
+	if err := d.Set("access_key_id", resp.AccessKeyID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("secret_access_key", resp.SecretAccessKey); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceAccessKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	csClient := m.(*ProviderMeta).Client
+
+	resp, err := csClient.ListAccessKeys(ctx, &client.ListAccessKeysRequest{
+		UserName: d.Get("user_name").(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, accessKeyID := range resp.AccessKeyIDs {
+		if accessKeyID == d.Id() {
+			return nil
+		}
+	}
+
+	// The key no longer exists on the platform; drop it from state so the next
+	// apply recreates it.
+	d.SetId("")
+
+	return nil
+}
+
+func resourceAccessKeyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	csClient := m.(*ProviderMeta).Client
+
+	req := &client.DeleteAccessKeyRequest{
+		UserName:    d.Get("user_name").(string),
+		AccessKeyID: d.Id(),
+	}
+	if err := csClient.DeleteAccessKey(ctx, req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
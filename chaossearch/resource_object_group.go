@@ -0,0 +1,220 @@
+package chaossearch
+
+import (
+	"context"
+	"terraform-provider-chaossearch/chaossearch/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceObjectGroup -
+func resourceObjectGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceObjectGroupCreate,
+		ReadContext:   resourceObjectGroupRead,
+		UpdateContext: resourceObjectGroupUpdate,
+		DeleteContext: resourceObjectGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"compression": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"format": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"pattern": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"filter_json": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"live_events_sqs_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"partition_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"index_retention": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"array_flatten_depth": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"keep_original": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"horizontal": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"column_renames": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"column_selection": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeMap},
+			},
+		},
+	}
+}
+
+func resourceObjectGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	csClient := m.(*ProviderMeta).Client
+
+	var arrayFlattenDepth *int
+	if v, ok := d.GetOk("array_flatten_depth"); ok {
+		depth := v.(int)
+		arrayFlattenDepth = &depth
+	}
+
+	req := &client.CreateObjectGroupRequest{
+		Name:              d.Get("name").(string),
+		Compression:       d.Get("compression").(string),
+		FilterJSON:        d.Get("filter_json").(string),
+		Format:            d.Get("format").(string),
+		LiveEventsSqsArn:  d.Get("live_events_sqs_arn").(string),
+		PartitionBy:       d.Get("partition_by").(string),
+		SourceBucket:      d.Get("source_bucket").(string),
+		Pattern:           d.Get("pattern").(string),
+		IndexRetention:    d.Get("index_retention").(int),
+		ArrayFlattenDepth: arrayFlattenDepth,
+		KeepOriginal:      d.Get("keep_original").(bool),
+		Horizontal:        d.Get("horizontal").(bool),
+		ColumnRenames:     d.Get("column_renames").(map[string]interface{}),
+		ColumnSelection:   columnSelectionFromResourceData(d),
+	}
+
+	if err := csClient.CreateObjectGroup(ctx, req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(req.Name)
+
+	return resourceObjectGroupRead(ctx, d, m)
+}
+
+// columnSelectionFromResourceData converts the column_selection list of maps
+// from Terraform's representation into the shape configureDatasetOptions
+// sends on, mirroring what readAttributesFromDatasetEndpoint parses back.
+func columnSelectionFromResourceData(d *schema.ResourceData) []map[string]interface{} {
+	rawColumnSelection := d.Get("column_selection").([]interface{})
+	if len(rawColumnSelection) == 0 {
+		return nil
+	}
+
+	columnSelection := make([]map[string]interface{}, len(rawColumnSelection))
+	for i, raw := range rawColumnSelection {
+		columnSelection[i] = raw.(map[string]interface{})
+	}
+
+	return columnSelection
+}
+
+func resourceObjectGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	csClient := m.(*ProviderMeta).Client
+
+	resp, err := csClient.ReadObjectGroup(ctx, &client.ReadObjectGroupRequest{ID: d.Id()})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("name", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("source_bucket", resp.SourceBucket); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("compression", resp.Compression); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("format", resp.Format); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("pattern", resp.Pattern); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("filter_json", resp.FilterJSON); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("live_events_sqs_arn", resp.LiveEventsSqsArn); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("partition_by", resp.PartitionBy); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("index_retention", resp.IndexRetention); err != nil {
+		return diag.FromErr(err)
+	}
+	if resp.ArrayFlattenDepth != nil {
+		if err := d.Set("array_flatten_depth", *resp.ArrayFlattenDepth); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := d.Set("keep_original", resp.KeepOriginal); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("horizontal", resp.Horizontal); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("column_renames", resp.ColumnRenames); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("column_selection", resp.ColumnSelection); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceObjectGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	csClient := m.(*ProviderMeta).Client
+
+	req := &client.UpdateObjectGroupRequest{
+		Name:           d.Id(),
+		IndexRetention: d.Get("index_retention").(int),
+	}
+
+	if err := csClient.UpdateObjectGroup(ctx, req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceObjectGroupRead(ctx, d, m)
+}
+
+func resourceObjectGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	csClient := m.(*ProviderMeta).Client
+
+	if err := csClient.DeleteObjectGroup(ctx, &client.DeleteObjectGroupRequest{Name: d.Id()}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
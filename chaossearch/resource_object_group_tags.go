@@ -0,0 +1,85 @@
+package chaossearch
+
+import (
+	"context"
+	"terraform-provider-chaossearch/chaossearch/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceObjectGroupTags manages arbitrary user-defined tags on the bucket
+// backing a chaossearch_object_group, leaving the cs3.* tags that ChaosSearch
+// itself writes untouched.
+func resourceObjectGroupTags() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceObjectGroupTagsCreateUpdate,
+		ReadContext:   resourceObjectGroupTagsRead,
+		UpdateContext: resourceObjectGroupTagsCreateUpdate,
+		DeleteContext: resourceObjectGroupTagsDelete,
+		Schema: map[string]*schema.Schema{
+			"object_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceObjectGroupTagsCreateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	csClient := m.(*ProviderMeta).Client
+
+	objectGroupName := d.Get("object_group_name").(string)
+	tags := map[string]string{}
+	for key, value := range d.Get("tags").(map[string]interface{}) {
+		tags[key] = value.(string)
+	}
+
+	req := &client.UpdateObjectGroupTagsRequest{
+		ObjectGroupName: objectGroupName,
+		Tags:            tags,
+	}
+	if err := csClient.UpdateObjectGroupTags(ctx, req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(objectGroupName)
+
+	return resourceObjectGroupTagsRead(ctx, d, m)
+}
+
+func resourceObjectGroupTagsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	csClient := m.(*ProviderMeta).Client
+
+	resp, err := csClient.ReadObjectGroupTags(ctx, &client.ReadObjectGroupTagsRequest{ObjectGroupName: d.Id()})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("object_group_name", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("tags", resp.Tags); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceObjectGroupTagsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	csClient := m.(*ProviderMeta).Client
+
+	if err := csClient.DeleteObjectGroupTags(ctx, d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package chaossearch
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"terraform-provider-chaossearch/chaossearch/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// TestAccObjectGroup_import creates an object group, then imports it by name
+// and checks the import is a no-op against the state Create left behind.
+// ImportStateVerify fails on any attribute that resourceObjectGroupRead
+// doesn't populate, which is the regression the chunk0-3 import support was
+// missing.
+func TestAccObjectGroup_import(t *testing.T) {
+	resourceName := "chaossearch_object_group.test"
+	name := resource.PrefixedUniqueId("tf-acc-test-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckObjectGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectGroupConfig(name, os.Getenv("CHAOSSEARCH_TEST_SOURCE_BUCKET")),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccObjectGroupConfig(name string, sourceBucket string) string {
+	return fmt.Sprintf(`
+resource "chaossearch_object_group" "test" {
+  name          = %q
+  source_bucket = %q
+  format        = "json"
+}
+`, name, sourceBucket)
+}
+
+func testAccCheckObjectGroupDestroy(s *terraform.State) error {
+	csClient := testAccProvider.Meta().(*ProviderMeta).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "chaossearch_object_group" {
+			continue
+		}
+
+		if _, err := csClient.ReadObjectGroup(context.Background(), &client.ReadObjectGroupRequest{ID: rs.Primary.ID}); err == nil {
+			return fmt.Errorf("object group %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package chaossearch
+
+import (
+	"context"
+	"terraform-provider-chaossearch/chaossearch/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceView -
+func resourceView() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceViewCreate,
+		ReadContext:   resourceViewRead,
+		UpdateContext: resourceViewUpdate,
+		DeleteContext: resourceViewDelete,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"object_groups": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"time_field": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"partition_by": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"filter_json": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"index_pattern": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"case_insensitive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceViewCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	csClient := m.(*ProviderMeta).Client
+
+	req := &client.CreateViewRequest{
+		Name:            d.Get("name").(string),
+		ObjectGroups:    toStringSlice(d.Get("object_groups").([]interface{})),
+		TimeField:       d.Get("time_field").(string),
+		PartitionBy:     d.Get("partition_by").(string),
+		FilterJSON:      d.Get("filter_json").(string),
+		IndexPattern:    d.Get("index_pattern").(string),
+		CaseInsensitive: d.Get("case_insensitive").(bool),
+	}
+
+	if err := csClient.CreateView(ctx, req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(req.Name)
+
+	return resourceViewRead(ctx, d, m)
+}
+
+func resourceViewRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	csClient := m.(*ProviderMeta).Client
+
+	resp, err := csClient.ReadView(ctx, &client.ReadViewRequest{ID: d.Id()})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("name", resp.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("object_groups", resp.ObjectGroups); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("time_field", resp.TimeField); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("partition_by", resp.PartitionBy); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("filter_json", resp.FilterJSON); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("index_pattern", resp.IndexPattern); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("case_insensitive", resp.CaseInsensitive); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceViewUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	csClient := m.(*ProviderMeta).Client
+
+	req := &client.UpdateViewRequest{
+		Name:            d.Get("name").(string),
+		ObjectGroups:    toStringSlice(d.Get("object_groups").([]interface{})),
+		TimeField:       d.Get("time_field").(string),
+		PartitionBy:     d.Get("partition_by").(string),
+		FilterJSON:      d.Get("filter_json").(string),
+		IndexPattern:    d.Get("index_pattern").(string),
+		CaseInsensitive: d.Get("case_insensitive").(bool),
+	}
+
+	if err := csClient.UpdateView(ctx, req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceViewRead(ctx, d, m)
+}
+
+func resourceViewDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	csClient := m.(*ProviderMeta).Client
+
+	if err := csClient.DeleteView(ctx, &client.DeleteViewRequest{Name: d.Id()}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func toStringSlice(raw []interface{}) []string {
+	result := make([]string, len(raw))
+	for i, v := range raw {
+		result[i] = v.(string)
+	}
+
+	return result
+}